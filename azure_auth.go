@@ -0,0 +1,145 @@
+package mcpgrafana
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+)
+
+// defaultGrafanaAADResource is the Azure AD application ID for Azure
+// Managed Grafana.
+const defaultGrafanaAADResource = "ce34e7e5-485f-4d76-964f-b3d2b16d1e4f"
+
+// AzureAuthMode selects which Azure AD credential chain AzureAuth.NewCredential
+// builds.
+type AzureAuthMode string
+
+const (
+	// AzureAuthModeDefault (also used when Mode is empty) uses
+	// azidentity.NewDefaultAzureCredential, trying each credential source
+	// in its standard order.
+	AzureAuthModeDefault AzureAuthMode = "default"
+	// AzureAuthModeWorkloadIdentity uses azidentity.NewWorkloadIdentityCredential,
+	// for AKS workload identity federation.
+	AzureAuthModeWorkloadIdentity AzureAuthMode = "workload-identity"
+	// AzureAuthModeManagedIdentity uses azidentity.NewManagedIdentityCredential,
+	// optionally scoped to a specific user-assigned identity via ClientID.
+	AzureAuthModeManagedIdentity AzureAuthMode = "managed-identity"
+	// AzureAuthModeClientSecret uses azidentity.NewClientSecretCredential.
+	AzureAuthModeClientSecret AzureAuthMode = "client-secret"
+	// AzureAuthModeAzureCLI uses azidentity.NewAzureCLICredential, useful
+	// for local development against an az-login'd account.
+	AzureAuthModeAzureCLI AzureAuthMode = "azure-cli"
+)
+
+// AzureAuth configures how a GrafanaConfig authenticates to Azure-Managed
+// Grafana via Azure AD. The zero value is equivalent to
+// AzureAuthModeDefault with no overrides.
+type AzureAuth struct {
+	// Mode selects the credential chain NewCredential builds. Defaults to
+	// AzureAuthModeDefault.
+	Mode AzureAuthMode
+
+	// ClientID is the client/app ID used by the workload-identity,
+	// managed-identity (user-assigned), and client-secret modes.
+	ClientID string
+
+	// TenantID is the Azure AD tenant to authenticate against. Required
+	// for client-secret and workload-identity; optional for default and
+	// azure-cli; ignored by managed-identity.
+	TenantID string
+
+	// ClientSecret is the application secret used by the client-secret
+	// mode.
+	ClientSecret string
+
+	// TokenFilePath is the path to the projected service account token
+	// used by the workload-identity mode. If unset, azidentity falls back
+	// to the AZURE_FEDERATED_TOKEN_FILE environment variable.
+	TokenFilePath string
+
+	// AuthorityHost overrides the Azure AD authority endpoint, for
+	// sovereign or otherwise non-public clouds.
+	AuthorityHost string
+
+	// Scopes overrides the scopes requested for every AAD token fetch.
+	// Defaults to [defaultGrafanaAADResource + "/.default"] if unset.
+	Scopes []string
+}
+
+func (a AzureAuth) isZero() bool {
+	return a.Mode == "" &&
+		a.ClientID == "" &&
+		a.TenantID == "" &&
+		a.ClientSecret == "" &&
+		a.TokenFilePath == "" &&
+		a.AuthorityHost == "" &&
+		len(a.Scopes) == 0
+}
+
+// ResolvedScopes returns a.Scopes, or the package default if unset.
+func (a AzureAuth) ResolvedScopes() []string {
+	if len(a.Scopes) > 0 {
+		return a.Scopes
+	}
+	return []string{defaultGrafanaAADResource + "/.default"}
+}
+
+func (a AzureAuth) clientOptions() azcore.ClientOptions {
+	var opts azcore.ClientOptions
+	if a.AuthorityHost != "" {
+		opts.Cloud = cloud.Configuration{ActiveDirectoryAuthorityHost: a.AuthorityHost}
+	}
+	return opts
+}
+
+// NewCredential builds the azcore.TokenCredential described by a.
+func (a AzureAuth) NewCredential() (azcore.TokenCredential, error) {
+	switch a.Mode {
+	case "", AzureAuthModeDefault:
+		return azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{
+			ClientOptions: a.clientOptions(),
+			TenantID:      a.TenantID,
+		})
+	case AzureAuthModeWorkloadIdentity:
+		return azidentity.NewWorkloadIdentityCredential(&azidentity.WorkloadIdentityCredentialOptions{
+			ClientOptions: a.clientOptions(),
+			ClientID:      a.ClientID,
+			TenantID:      a.TenantID,
+			TokenFilePath: a.TokenFilePath,
+		})
+	case AzureAuthModeManagedIdentity:
+		opts := &azidentity.ManagedIdentityCredentialOptions{ClientOptions: a.clientOptions()}
+		if a.ClientID != "" {
+			opts.ID = azidentity.ClientID(a.ClientID)
+		}
+		return azidentity.NewManagedIdentityCredential(opts)
+	case AzureAuthModeClientSecret:
+		return azidentity.NewClientSecretCredential(a.TenantID, a.ClientID, a.ClientSecret, &azidentity.ClientSecretCredentialOptions{
+			ClientOptions: a.clientOptions(),
+		})
+	case AzureAuthModeAzureCLI:
+		return azidentity.NewAzureCLICredential(&azidentity.AzureCLICredentialOptions{
+			TenantID: a.TenantID,
+		})
+	default:
+		return nil, fmt.Errorf("unknown azure auth mode %q", a.Mode)
+	}
+}
+
+// ResolveAADCredential returns c.AADCredential if one was set directly, for
+// backward compatibility with configs that construct their own credential.
+// Otherwise, if c.AzureAuth carries any non-default settings, it builds and
+// returns a credential from them. Returns a nil credential and nil error if
+// neither is configured.
+func (c GrafanaConfig) ResolveAADCredential() (azcore.TokenCredential, error) {
+	if c.AADCredential != nil {
+		return c.AADCredential, nil
+	}
+	if c.AzureAuth.isZero() {
+		return nil, nil
+	}
+	return c.AzureAuth.NewCredential()
+}
@@ -0,0 +1,62 @@
+package mcpgrafana
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+)
+
+type fakeAADCredential struct{}
+
+func (fakeAADCredential) GetToken(_ context.Context, _ policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{}, nil
+}
+
+func TestAzureAuth_ResolvedScopes(t *testing.T) {
+	var a AzureAuth
+	if got := a.ResolvedScopes(); len(got) != 1 || got[0] != defaultGrafanaAADResource+"/.default" {
+		t.Fatalf("expected default scope, got %v", got)
+	}
+
+	a.Scopes = []string{"custom-scope"}
+	if got := a.ResolvedScopes(); len(got) != 1 || got[0] != "custom-scope" {
+		t.Fatalf("expected overridden scope, got %v", got)
+	}
+}
+
+func TestGrafanaConfig_ResolveAADCredential_PrefersAADCredential(t *testing.T) {
+	cred := fakeAADCredential{}
+	cfg := GrafanaConfig{
+		AADCredential: cred,
+		AzureAuth:     AzureAuth{Mode: AzureAuthModeAzureCLI},
+	}
+
+	got, err := cfg.ResolveAADCredential()
+	if err != nil {
+		t.Fatalf("ResolveAADCredential returned error: %v", err)
+	}
+	if got != azcore.TokenCredential(cred) {
+		t.Fatalf("expected AADCredential to take precedence over AzureAuth")
+	}
+}
+
+func TestGrafanaConfig_ResolveAADCredential_NoneConfigured(t *testing.T) {
+	cfg := GrafanaConfig{}
+
+	got, err := cfg.ResolveAADCredential()
+	if err != nil {
+		t.Fatalf("ResolveAADCredential returned error: %v", err)
+	}
+	if got != nil {
+		t.Fatalf("expected nil credential when neither AADCredential nor AzureAuth is set, got %v", got)
+	}
+}
+
+func TestAzureAuth_NewCredential_UnknownMode(t *testing.T) {
+	a := AzureAuth{Mode: "bogus"}
+	if _, err := a.NewCredential(); err == nil {
+		t.Fatal("expected error for unknown azure auth mode")
+	}
+}
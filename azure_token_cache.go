@@ -0,0 +1,74 @@
+package mcpgrafana
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+)
+
+// defaultAADTokenRefreshSkew is how far ahead of a cached token's expiry
+// AzureTokenCache will treat it as stale and fetch a replacement.
+const defaultAADTokenRefreshSkew = 5 * time.Minute
+
+// AzureTokenCache caches Azure AD access tokens per scope so that repeated
+// calls to GetToken don't hit the IMDS/AAD endpoint on every request. It is
+// safe for concurrent use and is typically shared across every
+// alertingClient built from the same GrafanaConfig.
+type AzureTokenCache struct {
+	refreshSkew time.Duration
+
+	mu     sync.Mutex
+	tokens map[string]azcore.AccessToken
+}
+
+// NewAzureTokenCache returns an AzureTokenCache that refreshes tokens
+// defaultAADTokenRefreshSkew before they expire.
+func NewAzureTokenCache() *AzureTokenCache {
+	return &AzureTokenCache{
+		refreshSkew: defaultAADTokenRefreshSkew,
+		tokens:      make(map[string]azcore.AccessToken),
+	}
+}
+
+func scopeKey(scopes []string) string {
+	return strings.Join(scopes, ",")
+}
+
+// GetToken returns a cached token for the given scopes if one exists and
+// isn't within the cache's refresh skew of expiring, fetching and caching a
+// fresh one from cred otherwise.
+func (c *AzureTokenCache) GetToken(ctx context.Context, cred azcore.TokenCredential, scopes []string) (azcore.AccessToken, error) {
+	key := scopeKey(scopes)
+
+	c.mu.Lock()
+	token, ok := c.tokens[key]
+	c.mu.Unlock()
+	if ok && time.Until(token.ExpiresOn) > c.refreshSkew {
+		return token, nil
+	}
+
+	token, err := cred.GetToken(ctx, policy.TokenRequestOptions{Scopes: scopes})
+	if err != nil {
+		return azcore.AccessToken{}, err
+	}
+
+	c.mu.Lock()
+	c.tokens[key] = token
+	c.mu.Unlock()
+
+	return token, nil
+}
+
+// Invalidate drops any cached token for the given scopes, forcing the next
+// GetToken call to fetch a fresh one.
+func (c *AzureTokenCache) Invalidate(scopes []string) {
+	key := scopeKey(scopes)
+
+	c.mu.Lock()
+	delete(c.tokens, key)
+	c.mu.Unlock()
+}
@@ -0,0 +1,91 @@
+package mcpgrafana
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+)
+
+type fakeTokenCredential struct {
+	fetches   atomic.Int32
+	expiresIn time.Duration
+	err       error
+}
+
+func (f *fakeTokenCredential) GetToken(ctx context.Context, _ policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	f.fetches.Add(1)
+	if f.err != nil {
+		return azcore.AccessToken{}, f.err
+	}
+	return azcore.AccessToken{
+		Token:     "fake-token",
+		ExpiresOn: time.Now().Add(f.expiresIn),
+	}, nil
+}
+
+func TestAzureTokenCache_ReusesTokenUntilExpiry(t *testing.T) {
+	cred := &fakeTokenCredential{expiresIn: time.Hour}
+	cache := NewAzureTokenCache()
+
+	for i := 0; i < 5; i++ {
+		if _, err := cache.GetToken(context.Background(), cred, []string{"scope"}); err != nil {
+			t.Fatalf("GetToken returned error: %v", err)
+		}
+	}
+
+	if got := cred.fetches.Load(); got != 1 {
+		t.Fatalf("expected exactly 1 token fetch, got %d", got)
+	}
+}
+
+func TestAzureTokenCache_RefreshesWithinSkew(t *testing.T) {
+	cred := &fakeTokenCredential{expiresIn: time.Minute}
+	cache := NewAzureTokenCache()
+
+	if _, err := cache.GetToken(context.Background(), cred, []string{"scope"}); err != nil {
+		t.Fatalf("GetToken returned error: %v", err)
+	}
+	if _, err := cache.GetToken(context.Background(), cred, []string{"scope"}); err != nil {
+		t.Fatalf("GetToken returned error: %v", err)
+	}
+
+	if got := cred.fetches.Load(); got != 2 {
+		t.Fatalf("expected token within refresh skew to be refetched, got %d fetches", got)
+	}
+}
+
+func TestAzureTokenCache_Invalidate(t *testing.T) {
+	cred := &fakeTokenCredential{expiresIn: time.Hour}
+	cache := NewAzureTokenCache()
+
+	if _, err := cache.GetToken(context.Background(), cred, []string{"scope"}); err != nil {
+		t.Fatalf("GetToken returned error: %v", err)
+	}
+
+	cache.Invalidate([]string{"scope"})
+
+	if _, err := cache.GetToken(context.Background(), cred, []string{"scope"}); err != nil {
+		t.Fatalf("GetToken returned error: %v", err)
+	}
+
+	if got := cred.fetches.Load(); got != 2 {
+		t.Fatalf("expected invalidation to force a refetch, got %d fetches", got)
+	}
+}
+
+func TestAzureTokenCache_PropagatesAuthenticationFailedError(t *testing.T) {
+	cred := &fakeTokenCredential{err: &azidentity.AuthenticationFailedError{}}
+	cache := NewAzureTokenCache()
+
+	_, err := cache.GetToken(context.Background(), cred, []string{"scope"})
+	var authErr *azidentity.AuthenticationFailedError
+	if !errors.As(err, &authErr) {
+		t.Fatalf("expected AuthenticationFailedError, got %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+package mcpgrafana
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+)
+
+// TLSConfig holds the TLS client configuration used when talking to Grafana
+// and any services proxied through it (e.g. the alerting and Alertmanager
+// APIs).
+type TLSConfig struct {
+	CertFile           string
+	KeyFile            string
+	CAFile             string
+	InsecureSkipVerify bool
+}
+
+// HTTPTransport returns a copy of base configured with this TLSConfig's
+// certificate, key, and CA settings. If t is nil, base is returned as is.
+func (t *TLSConfig) HTTPTransport(base *http.Transport) (*http.Transport, error) {
+	if t == nil {
+		return base, nil
+	}
+
+	transport := base.Clone()
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: t.InsecureSkipVerify,
+	}
+
+	if t.CertFile != "" && t.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if t.CAFile != "" {
+		caCert, err := os.ReadFile(t.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		caCertPool := x509.NewCertPool()
+		if !caCertPool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no valid certificates found in CA file %s", t.CAFile)
+		}
+		tlsConfig.RootCAs = caCertPool
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
+// GrafanaConfig holds the Grafana connection details and credentials shared
+// by every tool client built for a single request.
+type GrafanaConfig struct {
+	// Debug enables verbose logging of outgoing requests.
+	Debug bool
+
+	// URL is the base URL of the Grafana instance, e.g. https://grafana.example.com.
+	URL string
+
+	// AccessToken and IDToken are used together for Grafana Cloud's
+	// X-Access-Token/X-Grafana-Id authentication scheme.
+	AccessToken string
+	IDToken     string
+
+	// APIKey is a Grafana API key or service account token, sent as a
+	// bearer token.
+	APIKey string
+
+	// BasicAuthUser and BasicAuthPassword authenticate to Grafana instances
+	// (often reverse-proxied ones) that expect HTTP Basic Auth. They are
+	// only applied when none of the token-based auth methods above are
+	// configured.
+	BasicAuthUser     string
+	BasicAuthPassword string
+
+	// CustomHeaders are merged onto every outgoing request after the
+	// default headers and any auth headers, letting callers satisfy
+	// reverse-proxy requirements such as X-Scope-OrgID or X-Forwarded-User.
+	CustomHeaders map[string]string
+
+	// AADCredential, when set, is used directly to authenticate to an
+	// Azure-Managed Grafana instance via Azure AD, taking precedence over
+	// AzureAuth. Most callers should configure AzureAuth instead; this
+	// field remains for callers that already construct their own
+	// azcore.TokenCredential.
+	AADCredential azcore.TokenCredential
+
+	// AzureAuth configures which Azure AD credential chain to build when
+	// AADCredential isn't set directly, e.g. to use workload identity
+	// federation or a user-assigned managed identity.
+	AzureAuth AzureAuth
+
+	// AADTokenCache, when set, is shared by every client built from this
+	// config so AAD tokens are fetched once and reused until they're close
+	// to expiring, instead of being requested on every call.
+	AADTokenCache *AzureTokenCache
+
+	// TLSConfig configures the TLS transport used for outgoing requests.
+	TLSConfig *TLSConfig
+}
+
+type grafanaConfigKey struct{}
+
+// WithGrafanaConfig returns a copy of ctx carrying cfg, retrievable via
+// GrafanaConfigFromContext.
+func WithGrafanaConfig(ctx context.Context, cfg GrafanaConfig) context.Context {
+	return context.WithValue(ctx, grafanaConfigKey{}, cfg)
+}
+
+// GrafanaConfigFromContext returns the GrafanaConfig previously stored in ctx
+// by WithGrafanaConfig, or the zero value if none is present.
+func GrafanaConfigFromContext(ctx context.Context) GrafanaConfig {
+	cfg, ok := ctx.Value(grafanaConfigKey{}).(GrafanaConfig)
+	if !ok {
+		return GrafanaConfig{}
+	}
+	return cfg
+}
@@ -0,0 +1,47 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListAlertRulesParams are the MCP-facing parameters for the
+// list_alert_rules tool. They mirror RuleQueryOptions so LLM callers can
+// narrow a query instead of retrieving every rule group in the instance.
+type ListAlertRulesParams struct {
+	RuleName     string `json:"ruleName,omitempty" jsonschema:"description=Only return rules whose name matches this value"`
+	DashboardUID string `json:"dashboardUid,omitempty" jsonschema:"description=Only return rules linked to this dashboard UID"`
+	PanelID      string `json:"panelId,omitempty" jsonschema:"description=Only return rules linked to this panel ID"`
+	FolderUID    string `json:"folderUid,omitempty" jsonschema:"description=Only return rules in this folder UID"`
+	RuleGroup    string `json:"ruleGroup,omitempty" jsonschema:"description=Only return rules in this rule group"`
+	State        string `json:"state,omitempty" jsonschema:"description=Only return rules in this state\\, e.g. firing\\, pending\\, inactive"`
+	Health       string `json:"health,omitempty" jsonschema:"description=Only return rules with this health\\, e.g. ok\\, error\\, nodata"`
+	Type         string `json:"type,omitempty" jsonschema:"description=Only return rules of this type\\, e.g. alerting\\, recording"`
+	MaxGroups    int    `json:"maxGroups,omitempty" jsonschema:"description=Maximum number of rule groups to return across all pages"`
+}
+
+func listAlertRules(ctx context.Context, args ListAlertRulesParams) ([]ruleGroup, error) {
+	client, err := newAlertingClientFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create alerting client: %w", err)
+	}
+
+	opts := RuleQueryOptions{
+		RuleName:     args.RuleName,
+		DashboardUID: args.DashboardUID,
+		PanelID:      args.PanelID,
+		FolderUID:    args.FolderUID,
+		RuleGroup:    args.RuleGroup,
+		State:        args.State,
+		Health:       args.Health,
+		Type:         args.Type,
+		MaxGroups:    args.MaxGroups,
+	}
+
+	groups, err := client.GetAllRules(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alert rules: %w", err)
+	}
+
+	return groups, nil
+}
@@ -7,29 +7,28 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/prometheus/prometheus/model/labels"
 
 	mcpgrafana "github.com/grafana/mcp-grafana"
 )
 
 const (
-	defaultTimeout               = 30 * time.Second
-	rulesEndpointPath            = "/api/prometheus/grafana/api/v1/rules"
-	defaultGrafanaAADResource    = "ce34e7e5-485f-4d76-964f-b3d2b16d1e4f"
+	defaultTimeout    = 30 * time.Second
+	rulesEndpointPath = "/api/prometheus/grafana/api/v1/rules"
+
+	// defaultMaxGroups bounds how many rule groups GetAllRules will
+	// accumulate in memory when a caller doesn't supply MaxGroups.
+	defaultMaxGroups = 10000
 )
 
 type alertingClient struct {
-	baseURL       *url.URL
-	accessToken   string
-	idToken       string
-	apiKey        string
-	httpClient    *http.Client
-	aadCredential *azidentity.DefaultAzureCredential
+	baseURL *url.URL
+	grafanaClientAuth
+	httpClient *http.Client
 }
 
 func newAlertingClientFromContext(ctx context.Context) (*alertingClient, error) {
@@ -40,30 +39,29 @@ func newAlertingClientFromContext(ctx context.Context) (*alertingClient, error)
 		return nil, fmt.Errorf("invalid Grafana base URL %q: %w", baseURL, err)
 	}
 
-	client := &alertingClient{
-		baseURL:       parsedBaseURL,
-		accessToken:   cfg.AccessToken,
-		idToken:       cfg.IDToken,
-		apiKey:        cfg.APIKey,
-		aadCredential: cfg.AADCredential,
-		httpClient: &http.Client{
-			Timeout: defaultTimeout,
-		},
+	httpClient, err := newGrafanaHTTPClient(cfg)
+	if err != nil {
+		return nil, err
 	}
 
-	// Create custom transport with TLS configuration if available
-	if tlsConfig := mcpgrafana.GrafanaConfigFromContext(ctx).TLSConfig; tlsConfig != nil {
-		client.httpClient.Transport, err = tlsConfig.HTTPTransport(http.DefaultTransport.(*http.Transport))
-		if err != nil {
-			return nil, fmt.Errorf("failed to create custom transport: %w", err)
-		}
+	auth, err := grafanaClientAuthFromConfig(cfg)
+	if err != nil {
+		return nil, err
 	}
 
-	return client, nil
+	return &alertingClient{
+		baseURL:           parsedBaseURL,
+		grafanaClientAuth: auth,
+		httpClient:        httpClient,
+	}, nil
 }
 
-func (c *alertingClient) makeRequest(ctx context.Context, path string) (*http.Response, error) {
-	p := c.baseURL.JoinPath(path).String()
+func (c *alertingClient) makeRequest(ctx context.Context, path string, query url.Values) (*http.Response, error) {
+	u := c.baseURL.JoinPath(path)
+	if len(query) > 0 {
+		u.RawQuery = query.Encode()
+	}
+	p := u.String()
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p, nil)
 	if err != nil {
@@ -73,21 +71,8 @@ func (c *alertingClient) makeRequest(ctx context.Context, path string) (*http.Re
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Content-Type", "application/json")
 
-	// If accessToken is set we use that first and fall back to normal Authorization.
-	if c.accessToken != "" && c.idToken != "" {
-		req.Header.Set("X-Access-Token", c.accessToken)
-		req.Header.Set("X-Grafana-Id", c.idToken)
-	} else if c.aadCredential != nil {
-		// Use AAD authentication
-		token, err := c.aadCredential.GetToken(ctx, policy.TokenRequestOptions{
-			Scopes: []string{defaultGrafanaAADResource},
-		})
-		if err != nil {
-			return nil, fmt.Errorf("failed to get AAD token for alerting client: %w", err)
-		}
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token.Token))
-	} else if c.apiKey != "" {
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+	if err := c.apply(ctx, req); err != nil {
+		return nil, fmt.Errorf("failed to authenticate request to %s: %w", p, err)
 	}
 
 	resp, err := c.httpClient.Do(req)
@@ -103,8 +88,67 @@ func (c *alertingClient) makeRequest(ctx context.Context, path string) (*http.Re
 	return resp, nil
 }
 
-func (c *alertingClient) GetRules(ctx context.Context) (*rulesResponse, error) {
-	resp, err := c.makeRequest(ctx, rulesEndpointPath)
+// RuleQueryOptions narrows and paginates a rules query. All fields are
+// optional; the zero value requests the server's default first page with no
+// filters applied.
+type RuleQueryOptions struct {
+	GroupLimit     int
+	GroupNextToken string
+	RuleName       string
+	DashboardUID   string
+	PanelID        string
+	FolderUID      string
+	RuleGroup      string
+	State          string
+	Health         string
+	Type           string
+
+	// MaxGroups bounds the total number of rule groups GetAllRules will
+	// accumulate before stopping, regardless of how many more pages the
+	// server reports. Defaults to defaultMaxGroups if zero or negative.
+	MaxGroups int
+}
+
+func (o RuleQueryOptions) toQuery() url.Values {
+	q := url.Values{}
+	if o.RuleName != "" {
+		q.Set("rule_name", o.RuleName)
+	}
+	if o.DashboardUID != "" {
+		q.Set("dashboard_uid", o.DashboardUID)
+	}
+	if o.PanelID != "" {
+		q.Set("panel_id", o.PanelID)
+	}
+	if o.FolderUID != "" {
+		q.Set("folder_uid", o.FolderUID)
+	}
+	if o.RuleGroup != "" {
+		q.Set("rule_group", o.RuleGroup)
+	}
+	if o.State != "" {
+		q.Set("state", o.State)
+	}
+	if o.Health != "" {
+		q.Set("health", o.Health)
+	}
+	if o.Type != "" {
+		q.Set("type", o.Type)
+	}
+	if o.GroupLimit > 0 {
+		q.Set("group_limit", strconv.Itoa(o.GroupLimit))
+	}
+	if o.GroupNextToken != "" {
+		q.Set("group_next_token", o.GroupNextToken)
+	}
+	return q
+}
+
+// GetRulesPage fetches a single page of rule groups matching opts. Use
+// opts.GroupNextToken with the NextToken returned on a prior page to
+// continue, or GetAllRules to follow every page automatically.
+func (c *alertingClient) GetRulesPage(ctx context.Context, opts RuleQueryOptions) (*rulesResponse, error) {
+	resp, err := c.makeRequest(ctx, rulesEndpointPath, opts.toQuery())
 	if err != nil {
 		return nil, fmt.Errorf("failed to get alert rules from Grafana API: %w", err)
 	}
@@ -119,6 +163,49 @@ func (c *alertingClient) GetRules(ctx context.Context) (*rulesResponse, error) {
 	return &rulesResponse, nil
 }
 
+// GetRules fetches the first page of rule groups with no filters applied.
+// Callers that need every rule group, or that want to filter server-side,
+// should use GetAllRules or GetRulesPage instead.
+func (c *alertingClient) GetRules(ctx context.Context) (*rulesResponse, error) {
+	return c.GetRulesPage(ctx, RuleQueryOptions{})
+}
+
+// GetAllRules follows opts.GroupNextToken across as many pages as the
+// server reports, returning every matching rule group. It stops early if
+// ctx is cancelled or once MaxGroups groups have been collected, so a
+// misbehaving server can't force unbounded memory growth.
+func (c *alertingClient) GetAllRules(ctx context.Context, opts RuleQueryOptions) ([]ruleGroup, error) {
+	maxGroups := opts.MaxGroups
+	if maxGroups <= 0 {
+		maxGroups = defaultMaxGroups
+	}
+
+	var groups []ruleGroup
+	nextToken := opts.GroupNextToken
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		pageOpts := opts
+		pageOpts.GroupNextToken = nextToken
+		page, err := c.GetRulesPage(ctx, pageOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, page.Data.RuleGroups...)
+		if len(groups) >= maxGroups {
+			return groups[:maxGroups], nil
+		}
+
+		if page.Data.NextToken == "" {
+			return groups, nil
+		}
+		nextToken = page.Data.NextToken
+	}
+}
+
 type rulesResponse struct {
 	Data struct {
 		RuleGroups []ruleGroup      `json:"groups"`
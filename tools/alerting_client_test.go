@@ -0,0 +1,256 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+
+	mcpgrafana "github.com/grafana/mcp-grafana"
+)
+
+// fakeAADCredential lets tests control token issuance without talking to
+// Azure AD. Setting failNext makes the next GetToken call return an
+// AuthenticationFailedError, so callers can exercise the
+// cached-token-invalidated-on-auth-failure path.
+type fakeAADCredential struct {
+	fetches  atomic.Int32
+	failNext atomic.Bool
+}
+
+func (f *fakeAADCredential) GetToken(ctx context.Context, _ policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	f.fetches.Add(1)
+	if f.failNext.CompareAndSwap(true, false) {
+		return azcore.AccessToken{}, &azidentity.AuthenticationFailedError{}
+	}
+	return azcore.AccessToken{Token: "aad-token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+func TestMakeRequest_BasicAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "grafana-user" || pass != "grafana-pass" {
+			t.Errorf("unexpected basic auth credentials: user=%q pass=%q ok=%v", user, pass, ok)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"data":{"groups":[]}}`))
+	}))
+	defer server.Close()
+
+	cfg := mcpgrafana.GrafanaConfig{
+		URL:               server.URL,
+		BasicAuthUser:     "grafana-user",
+		BasicAuthPassword: "grafana-pass",
+	}
+	ctx := mcpgrafana.WithGrafanaConfig(context.Background(), cfg)
+
+	client, err := newAlertingClientFromContext(ctx)
+	if err != nil {
+		t.Fatalf("newAlertingClientFromContext returned error: %v", err)
+	}
+
+	if _, err := client.GetRules(ctx); err != nil {
+		t.Fatalf("GetRules returned error: %v", err)
+	}
+}
+
+func TestMakeRequest_CustomHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Scope-OrgID"); got != "1" {
+			t.Errorf("expected X-Scope-OrgID header %q, got %q", "1", got)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if got := r.Header.Get("X-Forwarded-User"); got != "alice" {
+			t.Errorf("expected X-Forwarded-User header %q, got %q", "alice", got)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write([]byte(`{"data":{"groups":[]}}`))
+	}))
+	defer server.Close()
+
+	cfg := mcpgrafana.GrafanaConfig{
+		URL:    server.URL,
+		APIKey: "api-key",
+		CustomHeaders: map[string]string{
+			"X-Scope-OrgID":    "1",
+			"X-Forwarded-User": "alice",
+		},
+	}
+	ctx := mcpgrafana.WithGrafanaConfig(context.Background(), cfg)
+
+	client, err := newAlertingClientFromContext(ctx)
+	if err != nil {
+		t.Fatalf("newAlertingClientFromContext returned error: %v", err)
+	}
+
+	if _, err := client.GetRules(ctx); err != nil {
+		t.Fatalf("GetRules returned error: %v", err)
+	}
+}
+
+func TestGetAllRules_FollowsPagination(t *testing.T) {
+	pages := map[string]string{
+		"":      `{"data":{"groups":[{"name":"g1"},{"name":"g2"}],"groupNextToken":"page2"}}`,
+		"page2": `{"data":{"groups":[{"name":"g3"}]}}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := pages[r.URL.Query().Get("group_next_token")]
+		if !ok {
+			t.Errorf("unexpected group_next_token: %q", r.URL.Query().Get("group_next_token"))
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	ctx := mcpgrafana.WithGrafanaConfig(context.Background(), mcpgrafana.GrafanaConfig{URL: server.URL})
+	client, err := newAlertingClientFromContext(ctx)
+	if err != nil {
+		t.Fatalf("newAlertingClientFromContext returned error: %v", err)
+	}
+
+	groups, err := client.GetAllRules(ctx, RuleQueryOptions{})
+	if err != nil {
+		t.Fatalf("GetAllRules returned error: %v", err)
+	}
+
+	if len(groups) != 3 {
+		t.Fatalf("expected 3 groups across pages, got %d", len(groups))
+	}
+}
+
+func TestGetAllRules_RespectsMaxGroups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("group_next_token")
+		next := token + "x"
+		fmt.Fprintf(w, `{"data":{"groups":[{"name":"g"}],"groupNextToken":%q}}`, next)
+	}))
+	defer server.Close()
+
+	ctx := mcpgrafana.WithGrafanaConfig(context.Background(), mcpgrafana.GrafanaConfig{URL: server.URL})
+	client, err := newAlertingClientFromContext(ctx)
+	if err != nil {
+		t.Fatalf("newAlertingClientFromContext returned error: %v", err)
+	}
+
+	groups, err := client.GetAllRules(ctx, RuleQueryOptions{MaxGroups: 5})
+	if err != nil {
+		t.Fatalf("GetAllRules returned error: %v", err)
+	}
+
+	if len(groups) != 5 {
+		t.Fatalf("expected GetAllRules to stop at MaxGroups=5, got %d", len(groups))
+	}
+}
+
+func TestGetRulesPage_EncodesFilters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("rule_name") != "high-cpu" || q.Get("folder_uid") != "folder-1" || q.Get("state") != "firing" {
+			t.Errorf("unexpected query params: %v", q)
+		}
+		w.Write([]byte(`{"data":{"groups":[]}}`))
+	}))
+	defer server.Close()
+
+	ctx := mcpgrafana.WithGrafanaConfig(context.Background(), mcpgrafana.GrafanaConfig{URL: server.URL})
+	client, err := newAlertingClientFromContext(ctx)
+	if err != nil {
+		t.Fatalf("newAlertingClientFromContext returned error: %v", err)
+	}
+
+	_, err = client.GetRulesPage(ctx, RuleQueryOptions{
+		RuleName:  "high-cpu",
+		FolderUID: "folder-1",
+		State:     "firing",
+	})
+	if err != nil {
+		t.Fatalf("GetRulesPage returned error: %v", err)
+	}
+}
+
+func TestGetRules_AADTokenIsCachedAcrossCalls(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer aad-token" {
+			t.Errorf("unexpected Authorization header: %q", got)
+		}
+		w.Write([]byte(`{"data":{"groups":[]}}`))
+	}))
+	defer server.Close()
+
+	cred := &fakeAADCredential{}
+	client := &alertingClient{
+		baseURL: mustParseURL(t, server.URL),
+		grafanaClientAuth: grafanaClientAuth{
+			aadCredential: cred,
+			aadTokenCache: mcpgrafana.NewAzureTokenCache(),
+		},
+		httpClient: server.Client(),
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetRules(context.Background()); err != nil {
+			t.Fatalf("GetRules returned error on call %d: %v", i, err)
+		}
+	}
+
+	if got := cred.fetches.Load(); got != 1 {
+		t.Fatalf("expected exactly 1 AAD token fetch across 3 calls, got %d", got)
+	}
+}
+
+func TestGetRules_InvalidatesAndRetriesOnAuthFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{"groups":[]}}`))
+	}))
+	defer server.Close()
+
+	cred := &fakeAADCredential{}
+	cache := mcpgrafana.NewAzureTokenCache()
+	client := &alertingClient{
+		baseURL: mustParseURL(t, server.URL),
+		grafanaClientAuth: grafanaClientAuth{
+			aadCredential: cred,
+			aadTokenCache: cache,
+		},
+		httpClient: server.Client(),
+	}
+
+	// Prime the cache with a token, then force the next fetch to fail so the
+	// client must invalidate and retry.
+	if _, err := client.GetRules(context.Background()); err != nil {
+		t.Fatalf("GetRules returned error priming cache: %v", err)
+	}
+	cache.Invalidate(client.aadScopes)
+	cred.failNext.Store(true)
+
+	if _, err := client.GetRules(context.Background()); err != nil {
+		t.Fatalf("expected GetRules to recover after one retry, got error: %v", err)
+	}
+
+	if got := cred.fetches.Load(); got != 3 {
+		t.Fatalf("expected 3 fetches (prime, failed, retried), got %d", got)
+	}
+}
+
+func mustParseURL(t *testing.T, raw string) *url.URL {
+	t.Helper()
+	u, err := url.Parse(raw)
+	if err != nil {
+		t.Fatalf("failed to parse URL %q: %v", raw, err)
+	}
+	return u
+}
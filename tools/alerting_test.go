@@ -0,0 +1,43 @@
+package tools
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mcpgrafana "github.com/grafana/mcp-grafana"
+)
+
+func TestListAlertRules_EncodesParamsAndReturnsGroups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("rule_name") != "high-cpu" || q.Get("folder_uid") != "folder-1" || q.Get("state") != "firing" {
+			t.Errorf("unexpected query params: %v", q)
+		}
+		w.Write([]byte(`{"data":{"groups":[{"name":"g1"}]}}`))
+	}))
+	defer server.Close()
+
+	ctx := mcpgrafana.WithGrafanaConfig(context.Background(), mcpgrafana.GrafanaConfig{URL: server.URL})
+
+	groups, err := listAlertRules(ctx, ListAlertRulesParams{
+		RuleName:  "high-cpu",
+		FolderUID: "folder-1",
+		State:     "firing",
+	})
+	if err != nil {
+		t.Fatalf("listAlertRules returned error: %v", err)
+	}
+	if len(groups) != 1 || groups[0].Name != "g1" {
+		t.Fatalf("unexpected groups: %+v", groups)
+	}
+}
+
+func TestListAlertRules_PropagatesClientError(t *testing.T) {
+	ctx := mcpgrafana.WithGrafanaConfig(context.Background(), mcpgrafana.GrafanaConfig{URL: "://not-a-url"})
+
+	if _, err := listAlertRules(ctx, ListAlertRulesParams{}); err == nil {
+		t.Fatal("expected error for invalid Grafana URL")
+	}
+}
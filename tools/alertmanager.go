@@ -0,0 +1,175 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// matcherOperators lists the Prometheus-style matcher operators a filter
+// expression can use, longest first so that when two operators start at the
+// same index (e.g. "=~" and "="), the longer one wins.
+var matcherOperators = []struct {
+	op      string
+	isRegex bool
+	isEqual bool
+}{
+	{"!~", true, false},
+	{"=~", true, true},
+	{"!=", false, false},
+	{"=", false, true},
+}
+
+// parseMatcherExpr parses a single "label<op>value" filter expression,
+// e.g. "severity=critical" or "instance=~web-.*", into a Matcher. The
+// operator is whichever of =, !=, =~, !~ occurs earliest in expr, not
+// whichever is checked first, so that operator-like characters in a
+// regex value (common, since IsRegex matchers are free-form patterns)
+// don't get mistaken for the real separator.
+func parseMatcherExpr(expr string) (Matcher, error) {
+	bestIdx := -1
+	var best struct {
+		op      string
+		isRegex bool
+		isEqual bool
+	}
+	for _, o := range matcherOperators {
+		idx := strings.Index(expr, o.op)
+		if idx <= 0 {
+			continue
+		}
+		if bestIdx == -1 || idx < bestIdx {
+			bestIdx = idx
+			best = o
+		}
+	}
+	if bestIdx == -1 {
+		return Matcher{}, fmt.Errorf("invalid matcher expression %q, expected name<op>value with op one of =, !=, =~, !~", expr)
+	}
+
+	return Matcher{
+		Name:    strings.TrimSpace(expr[:bestIdx]),
+		Value:   strings.TrimSpace(expr[bestIdx+len(best.op):]),
+		IsRegex: best.isRegex,
+		IsEqual: best.isEqual,
+	}, nil
+}
+
+func parseMatcherExprs(exprs []string) ([]Matcher, error) {
+	matchers := make([]Matcher, 0, len(exprs))
+	for _, expr := range exprs {
+		matcher, err := parseMatcherExpr(expr)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, matcher)
+	}
+	return matchers, nil
+}
+
+// ListActiveAlertsParams are the MCP-facing parameters for the
+// list_active_alerts tool.
+type ListActiveAlertsParams struct {
+	Matchers  []string `json:"matchers,omitempty" jsonschema:"description=Label matchers\\, each in Prometheus format\\, e.g. severity=critical or instance=~web-.*"`
+	Silenced  *bool    `json:"silenced,omitempty" jsonschema:"description=Only return alerts that are (or aren't) silenced"`
+	Inhibited *bool    `json:"inhibited,omitempty" jsonschema:"description=Only return alerts that are (or aren't) inhibited"`
+	Active    *bool    `json:"active,omitempty" jsonschema:"description=Only return alerts that are (or aren't) active"`
+}
+
+func listActiveAlerts(ctx context.Context, args ListActiveAlertsParams) ([]GettableAlert, error) {
+	client, err := newAlertmanagerClientFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create alertmanager client: %w", err)
+	}
+
+	matchers, err := parseMatcherExprs(args.Matchers)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts, err := client.GetAlerts(ctx, AlertFilter{
+		Matchers:  matchers,
+		Silenced:  args.Silenced,
+		Inhibited: args.Inhibited,
+		Active:    args.Active,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active alerts: %w", err)
+	}
+
+	return alerts, nil
+}
+
+// ListSilencesParams are the MCP-facing parameters for the list_silences
+// tool. It currently takes no arguments; Alertmanager doesn't support
+// server-side filtering of silences.
+type ListSilencesParams struct{}
+
+func listSilences(ctx context.Context, _ ListSilencesParams) ([]GettableSilence, error) {
+	client, err := newAlertmanagerClientFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create alertmanager client: %w", err)
+	}
+
+	silences, err := client.GetSilences(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list silences: %w", err)
+	}
+
+	return silences, nil
+}
+
+// CreateSilenceParams are the MCP-facing parameters for the create_silence
+// tool.
+type CreateSilenceParams struct {
+	Matchers  []string  `json:"matchers" jsonschema:"description=Label matchers the silence applies to\\, each in Prometheus format\\, e.g. severity=critical"`
+	StartsAt  time.Time `json:"startsAt" jsonschema:"description=When the silence takes effect"`
+	EndsAt    time.Time `json:"endsAt" jsonschema:"description=When the silence expires"`
+	CreatedBy string    `json:"createdBy" jsonschema:"description=Who or what is creating this silence"`
+	Comment   string    `json:"comment" jsonschema:"description=Why this silence was created"`
+}
+
+func createSilence(ctx context.Context, args CreateSilenceParams) (string, error) {
+	client, err := newAlertmanagerClientFromContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to create alertmanager client: %w", err)
+	}
+
+	matchers, err := parseMatcherExprs(args.Matchers)
+	if err != nil {
+		return "", err
+	}
+
+	uid, err := client.CreateSilence(ctx, PostableSilence{
+		Matchers:  matchers,
+		StartsAt:  args.StartsAt,
+		EndsAt:    args.EndsAt,
+		CreatedBy: args.CreatedBy,
+		Comment:   args.Comment,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create silence: %w", err)
+	}
+
+	return uid, nil
+}
+
+// ExpireSilenceParams are the MCP-facing parameters for the expire_silence
+// tool.
+type ExpireSilenceParams struct {
+	UID string `json:"uid" jsonschema:"description=UID of the silence to expire"`
+}
+
+func expireSilence(ctx context.Context, args ExpireSilenceParams) (string, error) {
+	client, err := newAlertmanagerClientFromContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to create alertmanager client: %w", err)
+	}
+
+	if err := client.ExpireSilence(ctx, args.UID); err != nil {
+		return "", fmt.Errorf("failed to expire silence %s: %w", args.UID, err)
+	}
+
+	return fmt.Sprintf("silence %s expired", args.UID), nil
+}
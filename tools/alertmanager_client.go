@@ -0,0 +1,215 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	mcpgrafana "github.com/grafana/mcp-grafana"
+)
+
+const (
+	alertmanagerBasePath = "/api/alertmanager/grafana/api/v2"
+	alertsPath           = alertmanagerBasePath + "/alerts"
+	alertGroupsPath      = alertmanagerBasePath + "/alertGroups"
+	silencesPath         = alertmanagerBasePath + "/silences"
+)
+
+// alertmanagerClient talks to Grafana's Alertmanager proxy, which lets
+// callers see which alerts are currently firing (as opposed to
+// alertingClient, which only reads rule definitions) and manage silences.
+// It shares its auth and TLS plumbing with alertingClient.
+type alertmanagerClient struct {
+	baseURL *url.URL
+	grafanaClientAuth
+	httpClient *http.Client
+}
+
+func newAlertmanagerClientFromContext(ctx context.Context) (*alertmanagerClient, error) {
+	cfg := mcpgrafana.GrafanaConfigFromContext(ctx)
+	baseURL := strings.TrimRight(cfg.URL, "/")
+	parsedBaseURL, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Grafana base URL %q: %w", baseURL, err)
+	}
+
+	httpClient, err := newGrafanaHTTPClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	auth, err := grafanaClientAuthFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &alertmanagerClient{
+		baseURL:           parsedBaseURL,
+		grafanaClientAuth: auth,
+		httpClient:        httpClient,
+	}, nil
+}
+
+func (c *alertmanagerClient) makeRequest(ctx context.Context, method, path string, query url.Values, body any) (*http.Response, error) {
+	u := c.baseURL.JoinPath(path)
+	if len(query) > 0 {
+		u.RawQuery = query.Encode()
+	}
+	p := u.String()
+
+	var bodyReader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode request body for %s: %w", p, err)
+		}
+		bodyReader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, p, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request to %s: %w", p, err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	if err := c.apply(ctx, req); err != nil {
+		return nil, fmt.Errorf("failed to authenticate request to %s: %w", p, err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request to %s: %w", p, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, fmt.Errorf("Grafana Alertmanager API returned status code %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return resp, nil
+}
+
+// AlertFilter narrows a GetAlerts query to alerts matching every matcher,
+// plus whichever of Silenced/Inhibited/Active are set.
+type AlertFilter struct {
+	Matchers  []Matcher
+	Silenced  *bool
+	Inhibited *bool
+	Active    *bool
+}
+
+func (f AlertFilter) toQuery() url.Values {
+	q := url.Values{}
+	for _, m := range f.Matchers {
+		q.Add("filter", matcherToFilterExpr(m))
+	}
+	if f.Silenced != nil {
+		q.Set("silenced", strconv.FormatBool(*f.Silenced))
+	}
+	if f.Inhibited != nil {
+		q.Set("inhibited", strconv.FormatBool(*f.Inhibited))
+	}
+	if f.Active != nil {
+		q.Set("active", strconv.FormatBool(*f.Active))
+	}
+	return q
+}
+
+// matcherToFilterExpr renders a Matcher as the label="value" filter
+// expression Alertmanager's GET /alerts endpoint expects, e.g.
+// `severity="critical"` or `instance=~"web-.*"`.
+func matcherToFilterExpr(m Matcher) string {
+	var op string
+	switch {
+	case m.IsEqual && !m.IsRegex:
+		op = "="
+	case m.IsEqual && m.IsRegex:
+		op = "=~"
+	case !m.IsEqual && !m.IsRegex:
+		op = "!="
+	default:
+		op = "!~"
+	}
+	return fmt.Sprintf("%s%s%q", m.Name, op, m.Value)
+}
+
+// GetAlerts returns the active alerts matching filter.
+func (c *alertmanagerClient) GetAlerts(ctx context.Context, filter AlertFilter) ([]GettableAlert, error) {
+	resp, err := c.makeRequest(ctx, http.MethodGet, alertsPath, filter.toQuery(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active alerts: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var alerts []GettableAlert
+	if err := json.NewDecoder(resp.Body).Decode(&alerts); err != nil {
+		return nil, fmt.Errorf("failed to decode alerts response from %s: %w", alertsPath, err)
+	}
+	return alerts, nil
+}
+
+// GetAlertGroups returns the active alerts grouped the same way the
+// Grafana alerting UI groups them.
+func (c *alertmanagerClient) GetAlertGroups(ctx context.Context, filter AlertFilter) ([]AlertGroup, error) {
+	resp, err := c.makeRequest(ctx, http.MethodGet, alertGroupsPath, filter.toQuery(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alert groups: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var groups []AlertGroup
+	if err := json.NewDecoder(resp.Body).Decode(&groups); err != nil {
+		return nil, fmt.Errorf("failed to decode alert groups response from %s: %w", alertGroupsPath, err)
+	}
+	return groups, nil
+}
+
+// GetSilences returns every silence known to the Alertmanager, expired or
+// not.
+func (c *alertmanagerClient) GetSilences(ctx context.Context) ([]GettableSilence, error) {
+	resp, err := c.makeRequest(ctx, http.MethodGet, silencesPath, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list silences: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var silences []GettableSilence
+	if err := json.NewDecoder(resp.Body).Decode(&silences); err != nil {
+		return nil, fmt.Errorf("failed to decode silences response from %s: %w", silencesPath, err)
+	}
+	return silences, nil
+}
+
+// CreateSilence creates a new silence and returns its ID.
+func (c *alertmanagerClient) CreateSilence(ctx context.Context, silence PostableSilence) (string, error) {
+	resp, err := c.makeRequest(ctx, http.MethodPost, silencesPath, nil, silence)
+	if err != nil {
+		return "", fmt.Errorf("failed to create silence: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var created silenceCreateResponse
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		return "", fmt.Errorf("failed to decode create silence response from %s: %w", silencesPath, err)
+	}
+	return created.SilenceID, nil
+}
+
+// ExpireSilence expires the silence with the given uid immediately.
+func (c *alertmanagerClient) ExpireSilence(ctx context.Context, uid string) error {
+	path := silencesPath + "/" + url.PathEscape(uid)
+	resp, err := c.makeRequest(ctx, http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to expire silence %s: %w", uid, err)
+	}
+	resp.Body.Close()
+	return nil
+}
@@ -0,0 +1,138 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mcpgrafana "github.com/grafana/mcp-grafana"
+)
+
+func TestMatcherToFilterExpr(t *testing.T) {
+	cases := []struct {
+		matcher Matcher
+		want    string
+	}{
+		{Matcher{Name: "severity", Value: "critical", IsEqual: true}, `severity="critical"`},
+		{Matcher{Name: "severity", Value: "critical", IsEqual: false}, `severity!="critical"`},
+		{Matcher{Name: "instance", Value: "web-.*", IsRegex: true, IsEqual: true}, `instance=~"web-.*"`},
+		{Matcher{Name: "instance", Value: "web-.*", IsRegex: true, IsEqual: false}, `instance!~"web-.*"`},
+	}
+
+	for _, tc := range cases {
+		if got := matcherToFilterExpr(tc.matcher); got != tc.want {
+			t.Errorf("matcherToFilterExpr(%+v) = %q, want %q", tc.matcher, got, tc.want)
+		}
+	}
+}
+
+func TestGetAlerts_EncodesFilters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != alertsPath {
+			t.Errorf("expected path %q, got %q", alertsPath, r.URL.Path)
+		}
+		filters := r.URL.Query()["filter"]
+		if len(filters) != 1 || filters[0] != `severity="critical"` {
+			t.Errorf("unexpected filter params: %v", filters)
+		}
+		if r.URL.Query().Get("silenced") != "false" {
+			t.Errorf("expected silenced=false, got %q", r.URL.Query().Get("silenced"))
+		}
+		w.Write([]byte(`[{"labels":{"severity":"critical"},"status":{"state":"active"}}]`))
+	}))
+	defer server.Close()
+
+	ctx := mcpgrafana.WithGrafanaConfig(context.Background(), mcpgrafana.GrafanaConfig{URL: server.URL})
+	client, err := newAlertmanagerClientFromContext(ctx)
+	if err != nil {
+		t.Fatalf("newAlertmanagerClientFromContext returned error: %v", err)
+	}
+
+	silenced := false
+	alerts, err := client.GetAlerts(ctx, AlertFilter{
+		Matchers: []Matcher{{Name: "severity", Value: "critical", IsEqual: true}},
+		Silenced: &silenced,
+	})
+	if err != nil {
+		t.Fatalf("GetAlerts returned error: %v", err)
+	}
+	if len(alerts) != 1 || alerts[0].Labels["severity"] != "critical" {
+		t.Fatalf("unexpected alerts: %+v", alerts)
+	}
+}
+
+func TestCreateAndExpireSilence(t *testing.T) {
+	var created PostableSilence
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == silencesPath:
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				t.Fatalf("failed to decode silence body: %v", err)
+			}
+			w.Write([]byte(`{"silenceID":"silence-1"}`))
+		case r.Method == http.MethodDelete && r.URL.Path == silencesPath+"/silence-1":
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := mcpgrafana.WithGrafanaConfig(context.Background(), mcpgrafana.GrafanaConfig{URL: server.URL})
+	client, err := newAlertmanagerClientFromContext(ctx)
+	if err != nil {
+		t.Fatalf("newAlertmanagerClientFromContext returned error: %v", err)
+	}
+
+	uid, err := client.CreateSilence(ctx, PostableSilence{
+		Matchers:  []Matcher{{Name: "severity", Value: "critical", IsEqual: true}},
+		StartsAt:  time.Now(),
+		EndsAt:    time.Now().Add(time.Hour),
+		CreatedBy: "alice",
+		Comment:   "testing",
+	})
+	if err != nil {
+		t.Fatalf("CreateSilence returned error: %v", err)
+	}
+	if uid != "silence-1" {
+		t.Fatalf("expected silence ID %q, got %q", "silence-1", uid)
+	}
+	if created.CreatedBy != "alice" {
+		t.Fatalf("unexpected created silence: %+v", created)
+	}
+
+	if err := client.ExpireSilence(ctx, uid); err != nil {
+		t.Fatalf("ExpireSilence returned error: %v", err)
+	}
+}
+
+func TestParseMatcherExpr(t *testing.T) {
+	cases := []struct {
+		expr string
+		want Matcher
+	}{
+		{"severity=critical", Matcher{Name: "severity", Value: "critical", IsEqual: true}},
+		{"severity!=critical", Matcher{Name: "severity", Value: "critical", IsEqual: false}},
+		{"instance=~web-.*", Matcher{Name: "instance", Value: "web-.*", IsRegex: true, IsEqual: true}},
+		{"instance!~web-.*", Matcher{Name: "instance", Value: "web-.*", IsRegex: true, IsEqual: false}},
+		{"instance!=foo=~bar", Matcher{Name: "instance", Value: "foo=~bar", IsEqual: false}},
+	}
+
+	for _, tc := range cases {
+		got, err := parseMatcherExpr(tc.expr)
+		if err != nil {
+			t.Fatalf("parseMatcherExpr(%q) returned error: %v", tc.expr, err)
+		}
+		if got != tc.want {
+			t.Fatalf("parseMatcherExpr(%q) = %+v, want %+v", tc.expr, got, tc.want)
+		}
+	}
+
+	if _, err := parseMatcherExpr("no-operator-here"); err == nil {
+		t.Fatal("expected error for expression with no operator")
+	}
+}
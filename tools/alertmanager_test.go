@@ -0,0 +1,122 @@
+package tools
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mcpgrafana "github.com/grafana/mcp-grafana"
+)
+
+func TestListActiveAlerts_EncodesMatchersAndReturnsAlerts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		filters := r.URL.Query()["filter"]
+		if len(filters) != 1 || filters[0] != `severity="critical"` {
+			t.Errorf("unexpected filter params: %v", filters)
+		}
+		if r.URL.Query().Get("silenced") != "false" {
+			t.Errorf("expected silenced=false, got %q", r.URL.Query().Get("silenced"))
+		}
+		w.Write([]byte(`[{"labels":{"severity":"critical"},"status":{"state":"active"}}]`))
+	}))
+	defer server.Close()
+
+	ctx := mcpgrafana.WithGrafanaConfig(context.Background(), mcpgrafana.GrafanaConfig{URL: server.URL})
+	silenced := false
+
+	alerts, err := listActiveAlerts(ctx, ListActiveAlertsParams{
+		Matchers: []string{"severity=critical"},
+		Silenced: &silenced,
+	})
+	if err != nil {
+		t.Fatalf("listActiveAlerts returned error: %v", err)
+	}
+	if len(alerts) != 1 || alerts[0].Labels["severity"] != "critical" {
+		t.Fatalf("unexpected alerts: %+v", alerts)
+	}
+}
+
+func TestListActiveAlerts_PropagatesMatcherParseError(t *testing.T) {
+	ctx := mcpgrafana.WithGrafanaConfig(context.Background(), mcpgrafana.GrafanaConfig{URL: "http://example.invalid"})
+
+	if _, err := listActiveAlerts(ctx, ListActiveAlertsParams{Matchers: []string{"no-operator-here"}}); err == nil {
+		t.Fatal("expected error for unparseable matcher expression")
+	}
+}
+
+func TestListSilences_ReturnsSilences(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != silencesPath {
+			t.Errorf("expected path %q, got %q", silencesPath, r.URL.Path)
+		}
+		w.Write([]byte(`[{"id":"silence-1","comment":"testing"}]`))
+	}))
+	defer server.Close()
+
+	ctx := mcpgrafana.WithGrafanaConfig(context.Background(), mcpgrafana.GrafanaConfig{URL: server.URL})
+
+	silences, err := listSilences(ctx, ListSilencesParams{})
+	if err != nil {
+		t.Fatalf("listSilences returned error: %v", err)
+	}
+	if len(silences) != 1 || silences[0].Comment != "testing" {
+		t.Fatalf("unexpected silences: %+v", silences)
+	}
+}
+
+func TestCreateSilence_EncodesMatchersAndReturnsUID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != silencesPath {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"silenceID":"silence-1"}`))
+	}))
+	defer server.Close()
+
+	ctx := mcpgrafana.WithGrafanaConfig(context.Background(), mcpgrafana.GrafanaConfig{URL: server.URL})
+
+	uid, err := createSilence(ctx, CreateSilenceParams{
+		Matchers:  []string{"severity=critical"},
+		StartsAt:  time.Now(),
+		EndsAt:    time.Now().Add(time.Hour),
+		CreatedBy: "alice",
+		Comment:   "testing",
+	})
+	if err != nil {
+		t.Fatalf("createSilence returned error: %v", err)
+	}
+	if uid != "silence-1" {
+		t.Fatalf("expected silence ID %q, got %q", "silence-1", uid)
+	}
+}
+
+func TestCreateSilence_PropagatesMatcherParseError(t *testing.T) {
+	ctx := mcpgrafana.WithGrafanaConfig(context.Background(), mcpgrafana.GrafanaConfig{URL: "http://example.invalid"})
+
+	_, err := createSilence(ctx, CreateSilenceParams{Matchers: []string{"no-operator-here"}})
+	if err == nil {
+		t.Fatal("expected error for unparseable matcher expression")
+	}
+}
+
+func TestExpireSilence_ReturnsConfirmationMessage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != silencesPath+"/silence-1" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctx := mcpgrafana.WithGrafanaConfig(context.Background(), mcpgrafana.GrafanaConfig{URL: server.URL})
+
+	msg, err := expireSilence(ctx, ExpireSilenceParams{UID: "silence-1"})
+	if err != nil {
+		t.Fatalf("expireSilence returned error: %v", err)
+	}
+	if msg != "silence silence-1 expired" {
+		t.Fatalf("unexpected message: %q", msg)
+	}
+}
@@ -0,0 +1,76 @@
+package tools
+
+import "time"
+
+// Matcher is a single Alertmanager label matcher, used both to describe
+// why an alert is grouped and to scope a silence.
+type Matcher struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	IsRegex bool   `json:"isRegex"`
+	IsEqual bool   `json:"isEqual"`
+}
+
+// GettableAlert is a single active alert as returned by the Alertmanager
+// v2 API's GET /alerts and GET /alertGroups endpoints.
+type GettableAlert struct {
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	UpdatedAt    time.Time         `json:"updatedAt"`
+	Fingerprint  string            `json:"fingerprint"`
+	GeneratorURL string            `json:"generatorURL,omitempty"`
+	Status       AlertStatus       `json:"status"`
+	Receivers    []Receiver        `json:"receivers,omitempty"`
+}
+
+// AlertStatus describes whether an alert is active, suppressed, or
+// unprocessed, and which silences/inhibitions, if any, are suppressing it.
+type AlertStatus struct {
+	State       string   `json:"state"`
+	SilencedBy  []string `json:"silencedBy,omitempty"`
+	InhibitedBy []string `json:"inhibitedBy,omitempty"`
+}
+
+// Receiver identifies a configured Alertmanager receiver.
+type Receiver struct {
+	Name string `json:"name"`
+}
+
+// AlertGroup is a set of alerts that share a group key, as returned by GET
+// /alertGroups.
+type AlertGroup struct {
+	Labels   map[string]string `json:"labels"`
+	Receiver Receiver          `json:"receiver"`
+	Alerts   []GettableAlert   `json:"alerts"`
+}
+
+// GettableSilence is a silence as returned by GET /silences.
+type GettableSilence struct {
+	ID        string    `json:"id"`
+	Matchers  []Matcher `json:"matchers"`
+	StartsAt  time.Time `json:"startsAt"`
+	EndsAt    time.Time `json:"endsAt"`
+	CreatedBy string    `json:"createdBy"`
+	Comment   string    `json:"comment"`
+	Status    struct {
+		State string `json:"state"`
+	} `json:"status"`
+}
+
+// PostableSilence is the payload sent to POST /silences to create or
+// update a silence. Leaving ID empty creates a new silence.
+type PostableSilence struct {
+	ID        string    `json:"id,omitempty"`
+	Matchers  []Matcher `json:"matchers"`
+	StartsAt  time.Time `json:"startsAt"`
+	EndsAt    time.Time `json:"endsAt"`
+	CreatedBy string    `json:"createdBy"`
+	Comment   string    `json:"comment"`
+}
+
+// silenceCreateResponse is returned by POST /silences.
+type silenceCreateResponse struct {
+	SilenceID string `json:"silenceID"`
+}
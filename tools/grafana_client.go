@@ -0,0 +1,112 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+
+	mcpgrafana "github.com/grafana/mcp-grafana"
+)
+
+// grafanaClientAuth holds the credentials and extra headers shared by every
+// Grafana-proxied HTTP client in this package (alertingClient,
+// alertmanagerClient, ...), and knows how to apply them to an outgoing
+// request using the same precedence rules everywhere.
+type grafanaClientAuth struct {
+	accessToken   string
+	idToken       string
+	apiKey        string
+	basicAuthUser string
+	basicAuthPass string
+	customHeaders map[string]string
+	aadCredential azcore.TokenCredential
+	aadTokenCache *mcpgrafana.AzureTokenCache
+	aadScopes     []string
+}
+
+func grafanaClientAuthFromConfig(cfg mcpgrafana.GrafanaConfig) (grafanaClientAuth, error) {
+	cred, err := cfg.ResolveAADCredential()
+	if err != nil {
+		return grafanaClientAuth{}, fmt.Errorf("failed to resolve Azure AD credential: %w", err)
+	}
+
+	return grafanaClientAuth{
+		accessToken:   cfg.AccessToken,
+		idToken:       cfg.IDToken,
+		apiKey:        cfg.APIKey,
+		basicAuthUser: cfg.BasicAuthUser,
+		basicAuthPass: cfg.BasicAuthPassword,
+		customHeaders: cfg.CustomHeaders,
+		aadCredential: cred,
+		aadTokenCache: cfg.AADTokenCache,
+		aadScopes:     cfg.AzureAuth.ResolvedScopes(),
+	}, nil
+}
+
+// apply sets auth and custom headers on req. If accessToken/idToken are set
+// they're used first, then AAD, then apiKey, then HTTP Basic Auth as a
+// fallback; custom headers are merged in last so they can override any of
+// the above.
+func (a grafanaClientAuth) apply(ctx context.Context, req *http.Request) error {
+	switch {
+	case a.accessToken != "" && a.idToken != "":
+		req.Header.Set("X-Access-Token", a.accessToken)
+		req.Header.Set("X-Grafana-Id", a.idToken)
+	case a.aadCredential != nil:
+		token, err := a.getAADToken(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get AAD token: %w", err)
+		}
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token.Token))
+	case a.apiKey != "":
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", a.apiKey))
+	case a.basicAuthUser != "" || a.basicAuthPass != "":
+		req.SetBasicAuth(a.basicAuthUser, a.basicAuthPass)
+	}
+
+	for key, value := range a.customHeaders {
+		req.Header.Set(key, value)
+	}
+
+	return nil
+}
+
+// getAADToken returns a token for a.aadScopes, preferring the shared
+// aadTokenCache when one is configured so repeated calls don't hit the
+// AAD/IMDS endpoint on every request. If fetching a token through the cache
+// fails with an AuthenticationFailedError, the cache entry for a.aadScopes
+// is invalidated and the fetch is retried exactly once.
+func (a grafanaClientAuth) getAADToken(ctx context.Context) (azcore.AccessToken, error) {
+	if a.aadTokenCache == nil {
+		return a.aadCredential.GetToken(ctx, policy.TokenRequestOptions{Scopes: a.aadScopes})
+	}
+
+	token, err := a.aadTokenCache.GetToken(ctx, a.aadCredential, a.aadScopes)
+	var authErr *azidentity.AuthenticationFailedError
+	if errors.As(err, &authErr) {
+		a.aadTokenCache.Invalidate(a.aadScopes)
+		token, err = a.aadTokenCache.GetToken(ctx, a.aadCredential, a.aadScopes)
+	}
+	return token, err
+}
+
+// newGrafanaHTTPClient builds an *http.Client with defaultTimeout and, if
+// cfg carries a TLSConfig, a transport configured from it.
+func newGrafanaHTTPClient(cfg mcpgrafana.GrafanaConfig) (*http.Client, error) {
+	client := &http.Client{Timeout: defaultTimeout}
+
+	if cfg.TLSConfig != nil {
+		transport, err := cfg.TLSConfig.HTTPTransport(http.DefaultTransport.(*http.Transport))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create custom transport: %w", err)
+		}
+		client.Transport = transport
+	}
+
+	return client, nil
+}